@@ -0,0 +1,58 @@
+package mberror
+
+import (
+	"fmt"
+	"time"
+)
+
+// BadRequestError indicates the server rejected the request as malformed
+// (HTTP 400, or an equivalent backend errno).
+type BadRequestError struct {
+	Message string
+}
+
+func (e *BadRequestError) Error() string {
+	return fmt.Sprintf("bad request: %s", e.Message)
+}
+
+// UnauthorizedError indicates missing or rejected credentials (HTTP 401/403,
+// or an equivalent backend errno).
+type UnauthorizedError struct {
+	Message string
+}
+
+func (e *UnauthorizedError) Error() string {
+	return fmt.Sprintf("unauthorized: %s", e.Message)
+}
+
+// RateLimitedError indicates the caller is being throttled (HTTP 429).
+// RetryAfter is parsed from the response's Retry-After header and is zero
+// when the server didn't send one.
+type RateLimitedError struct {
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("rate limited: %s (retry after %s)", e.Message, e.RetryAfter)
+}
+
+// ServerError indicates a generic server-side failure that doesn't map to
+// one of the more specific error types.
+type ServerError struct {
+	Message string
+}
+
+func (e *ServerError) Error() string {
+	return fmt.Sprintf("server error: %s", e.Message)
+}
+
+// ServiceUnavailableError indicates the backend is temporarily unable to
+// handle the request (HTTP 503).
+type ServiceUnavailableError struct {
+	Message string
+}
+
+func (e *ServiceUnavailableError) Error() string {
+	return fmt.Sprintf("service unavailable: %s", e.Message)
+}
@@ -2,20 +2,29 @@ package network
 
 import (
 	"encoding/json"
+	"fmt"
+	"math/rand"
 	"net/http"
-	
+	"strconv"
+	"time"
+
 	mberror "github.com/memodb-io/memobase/src/client/memobase-go/error"
 )
 
 type BaseResponse struct {
 	Data   interface{} `json:"data"`
-	Errmsg string                 `json:"errmsg"`
-	Errno  int                    `json:"errno"`
+	Errmsg string      `json:"errmsg"`
+	Errno  int         `json:"errno"`
 }
 
+// UnpackResponse decodes a BaseResponse, mapping HTTP statuses of 400 and
+// above and non-zero backend errno codes to a typed mberror so callers can
+// errors.As on the failure they care about.
 func UnpackResponse(resp *http.Response) (*BaseResponse, error) {
+	defer resp.Body.Close()
+
 	if resp.StatusCode >= 400 {
-		return nil, &mberror.ServerError{Message: resp.Status}
+		return nil, errorForCode(resp.StatusCode, resp.Status, resp.Header)
 	}
 
 	var baseResp BaseResponse
@@ -24,8 +33,192 @@ func UnpackResponse(resp *http.Response) (*BaseResponse, error) {
 	}
 
 	if baseResp.Errno != 0 {
-		return nil, &mberror.ServerError{Message: baseResp.Errmsg}
+		return nil, errorForCode(baseResp.Errno, baseResp.Errmsg, resp.Header)
 	}
 
 	return &baseResp, nil
-} 
\ No newline at end of file
+}
+
+// UnpackStream decodes a BaseResponse whose "data" field is a large array,
+// invoking fn for each element as it's decoded instead of buffering the
+// whole body through json.Decode like UnpackResponse does.
+func UnpackStream[T any](resp *http.Response, fn func(T) error) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return errorForCode(resp.StatusCode, resp.Status, resp.Header)
+	}
+
+	dec := json.NewDecoder(resp.Body)
+
+	if _, err := dec.Token(); err != nil { // consume the opening '{'
+		return fmt.Errorf("decoding stream: %w", err)
+	}
+
+	var errmsg string
+	var errno int
+
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("decoding stream: %w", err)
+		}
+
+		switch keyTok {
+		case "data":
+			if err := decodeDataArray(dec, fn); err != nil {
+				return err
+			}
+		case "errmsg":
+			if err := dec.Decode(&errmsg); err != nil {
+				return fmt.Errorf("decoding stream: %w", err)
+			}
+		case "errno":
+			if err := dec.Decode(&errno); err != nil {
+				return fmt.Errorf("decoding stream: %w", err)
+			}
+		default:
+			var discard interface{}
+			if err := dec.Decode(&discard); err != nil {
+				return fmt.Errorf("decoding stream: %w", err)
+			}
+		}
+	}
+
+	if errno != 0 {
+		return errorForCode(errno, errmsg, resp.Header)
+	}
+	return nil
+}
+
+// decodeDataArray streams the elements of a JSON array via dec, calling fn
+// for each one without holding the whole array in memory.
+func decodeDataArray[T any](dec *json.Decoder, fn func(T) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("decoding data array: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected data array, got %v", tok)
+	}
+
+	for dec.More() {
+		var item T
+		if err := dec.Decode(&item); err != nil {
+			return fmt.Errorf("decoding data element: %w", err)
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+
+	if _, err := dec.Token(); err != nil { // consume the closing ']'
+		return fmt.Errorf("decoding data array: %w", err)
+	}
+	return nil
+}
+
+// errorForCode maps an HTTP status (or a backend errno that mirrors HTTP
+// status conventions) to a typed mberror.
+func errorForCode(code int, message string, header http.Header) error {
+	switch code {
+	case http.StatusBadRequest:
+		return &mberror.BadRequestError{Message: message}
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return &mberror.UnauthorizedError{Message: message}
+	case http.StatusTooManyRequests:
+		return &mberror.RateLimitedError{Message: message, RetryAfter: parseRetryAfter(header)}
+	case http.StatusServiceUnavailable:
+		return &mberror.ServiceUnavailableError{Message: message}
+	default:
+		return &mberror.ServerError{Message: message}
+	}
+}
+
+// parseRetryAfter reads the Retry-After header as a number of seconds,
+// returning 0 if it's absent or malformed.
+func parseRetryAfter(header http.Header) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// Client performs HTTP requests that return a BaseResponse, retrying
+// rate-limited, service-unavailable, and network-level failures with
+// bounded, jittered exponential backoff.
+type Client struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// NewClient returns a Client with conservative retry defaults.
+func NewClient() *Client {
+	return &Client{
+		MaxRetries: 3,
+		BaseDelay:  200 * time.Millisecond,
+		MaxDelay:   5 * time.Second,
+	}
+}
+
+// Do calls request to perform one HTTP round trip, retrying on network
+// errors, rate limiting, and service-unavailable responses up to
+// MaxRetries times before giving up and returning the last error.
+func (c *Client) Do(request func() (*http.Response, error)) (*BaseResponse, error) {
+	var lastErr error
+
+	for attempt := 0; ; attempt++ {
+		resp, err := request()
+		if err != nil {
+			lastErr = err
+			if attempt >= c.MaxRetries {
+				return nil, lastErr
+			}
+			time.Sleep(c.backoff(attempt, 0))
+			continue
+		}
+
+		baseResp, err := UnpackResponse(resp)
+		if err == nil {
+			return baseResp, nil
+		}
+		lastErr = err
+
+		retryAfter := time.Duration(0)
+		switch e := err.(type) {
+		case *mberror.RateLimitedError:
+			retryAfter = e.RetryAfter
+		case *mberror.ServiceUnavailableError:
+			// no server-specified delay; fall back to computed backoff
+		default:
+			return nil, err
+		}
+
+		if attempt >= c.MaxRetries {
+			return nil, lastErr
+		}
+		time.Sleep(c.backoff(attempt, retryAfter))
+	}
+}
+
+// backoff computes the delay before retry number attempt, honoring the
+// server's Retry-After when one was given and otherwise using jittered
+// exponential backoff bounded by MaxDelay.
+func (c *Client) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	delay := c.BaseDelay * time.Duration(1<<uint(attempt))
+	if delay > c.MaxDelay {
+		delay = c.MaxDelay
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay)/2 + 1))
+	return delay/2 + jitter
+}
@@ -0,0 +1,331 @@
+// Package scanner extracts function and type declarations from Go source
+// via go/parser and go/ast. It's shared by gofuncs' own CLI and by
+// validate-docs' Go LanguageBackend, so both walk the AST the same way
+// instead of one of them shelling out to the other.
+package scanner
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FunctionInfo describes a single function or method declaration.
+type FunctionInfo struct {
+	File     string
+	Line     int
+	Type     string // "f" for a function, "m" for a method
+	Exported bool
+	Name     string
+	Receiver string
+	Params   []string
+	Returns  []string
+
+	// Doc, IsTestFile, Complexity, and Package are populated for callers
+	// that want more than the bare signature (gofuncs' -output json/sarif).
+	Doc        string `json:",omitempty"`
+	IsTestFile bool
+	Complexity int
+	Package    string
+}
+
+// TypeInfo describes a struct or interface declaration, whether at package
+// level or inside a function body.
+type TypeInfo struct {
+	File     string
+	Line     int
+	Package  string
+	Name     string
+	Exported bool
+	Kind     string // "struct" or "interface"
+	Members  []string
+}
+
+// skipDirs are never descended into: they hold vendored or generated code
+// that isn't worth parsing, and may not even be valid Go the installed
+// toolchain can parse.
+var skipDirs = []string{".git", "node_modules", "vendor"}
+
+// ExtractAll walks dir once, collecting both function signatures and
+// struct/interface declarations from every .go file under it. A file that
+// fails to parse is skipped, not treated as a fatal error.
+func ExtractAll(dir string) ([]FunctionInfo, []TypeInfo, error) {
+	var functions []FunctionInfo
+	var types []TypeInfo
+	fset := token.NewFileSet()
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			for _, d := range skipDirs {
+				if info.Name() == d {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		relPath, _ := filepath.Rel(dir, path)
+		isTestFile := strings.HasSuffix(path, "_test.go")
+
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		node, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+		if err != nil {
+			// A file that fails to parse is skipped rather than aborting the
+			// whole scan; one malformed file shouldn't blank out results for
+			// every other valid file in the tree.
+			return nil
+		}
+
+		pkg := node.Name.Name
+
+		ast.Inspect(node, func(n ast.Node) bool {
+			switch x := n.(type) {
+			case *ast.FuncDecl:
+				if x.Name == nil {
+					return true
+				}
+
+				pos := fset.Position(x.Pos())
+				fn := FunctionInfo{
+					File:       relPath,
+					Line:       pos.Line,
+					Name:       x.Name.Name,
+					Exported:   ast.IsExported(x.Name.Name),
+					Doc:        x.Doc.Text(),
+					IsTestFile: isTestFile,
+					Complexity: cyclomaticComplexity(x.Body),
+					Package:    pkg,
+				}
+
+				if x.Recv != nil && len(x.Recv.List) > 0 {
+					fn.Type = "m"
+					fn.Receiver = typeToString(x.Recv.List[0].Type)
+				} else {
+					fn.Type = "f"
+				}
+
+				if x.Type.Params != nil {
+					for _, param := range x.Type.Params.List {
+						paramType := typeToString(param.Type)
+						if len(param.Names) == 0 {
+							fn.Params = append(fn.Params, paramType)
+						} else {
+							for range param.Names {
+								fn.Params = append(fn.Params, paramType)
+							}
+						}
+					}
+				}
+
+				if x.Type.Results != nil {
+					for _, result := range x.Type.Results.List {
+						resultType := typeToString(result.Type)
+						if len(result.Names) == 0 {
+							fn.Returns = append(fn.Returns, resultType)
+						} else {
+							for range result.Names {
+								fn.Returns = append(fn.Returns, resultType)
+							}
+						}
+					}
+				}
+
+				functions = append(functions, fn)
+
+			case *ast.TypeSpec:
+				if t := typeSpecInfo(fset, relPath, pkg, x); t != nil {
+					types = append(types, *t)
+				}
+			}
+			return true
+		})
+
+		return nil
+	})
+
+	return functions, types, err
+}
+
+// typeSpecInfo builds a TypeInfo for struct and interface declarations; it
+// returns nil for any other kind of type declaration (aliases, etc).
+func typeSpecInfo(fset *token.FileSet, relPath, pkg string, ts *ast.TypeSpec) *TypeInfo {
+	pos := fset.Position(ts.Pos())
+	info := TypeInfo{
+		File:     relPath,
+		Line:     pos.Line,
+		Package:  pkg,
+		Name:     ts.Name.Name,
+		Exported: ast.IsExported(ts.Name.Name),
+	}
+
+	switch t := ts.Type.(type) {
+	case *ast.StructType:
+		info.Kind = "struct"
+		if t.Fields != nil {
+			for _, field := range t.Fields.List {
+				fieldType := typeToString(field.Type)
+				if len(field.Names) == 0 {
+					info.Members = append(info.Members, fieldType)
+				} else {
+					for _, name := range field.Names {
+						info.Members = append(info.Members, name.Name+" "+fieldType)
+					}
+				}
+			}
+		}
+	case *ast.InterfaceType:
+		info.Kind = "interface"
+		if t.Methods != nil {
+			for _, method := range t.Methods.List {
+				if funcType, ok := method.Type.(*ast.FuncType); ok && len(method.Names) > 0 {
+					info.Members = append(info.Members, method.Names[0].Name+buildFuncSignature(funcType))
+				} else {
+					info.Members = append(info.Members, typeToString(method.Type))
+				}
+			}
+		}
+	default:
+		return nil
+	}
+
+	return &info
+}
+
+// cyclomaticComplexity approximates McCabe complexity by counting branching
+// constructs and short-circuit boolean operators in the function body.
+func cyclomaticComplexity(body *ast.BlockStmt) int {
+	complexity := 1
+	if body == nil {
+		return complexity
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt, *ast.CaseClause, *ast.CommClause:
+			complexity++
+		case *ast.BinaryExpr:
+			if x.Op == token.LAND || x.Op == token.LOR {
+				complexity++
+			}
+		}
+		return true
+	})
+
+	return complexity
+}
+
+func typeToString(expr ast.Expr) string {
+	switch x := expr.(type) {
+	case *ast.Ident:
+		return x.Name
+	case *ast.StarExpr:
+		return "*" + typeToString(x.X)
+	case *ast.ArrayType:
+		if x.Len == nil {
+			return "[]" + typeToString(x.Elt)
+		}
+		return "[" + typeToString(x.Len) + "]" + typeToString(x.Elt)
+	case *ast.MapType:
+		return "map[" + typeToString(x.Key) + "]" + typeToString(x.Value)
+	case *ast.ChanType:
+		switch x.Dir {
+		case ast.SEND:
+			return "chan<- " + typeToString(x.Value)
+		case ast.RECV:
+			return "<-chan " + typeToString(x.Value)
+		default:
+			return "chan " + typeToString(x.Value)
+		}
+	case *ast.FuncType:
+		return "func" + buildFuncSignature(x)
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.StructType:
+		return "struct{}"
+	case *ast.SelectorExpr:
+		return typeToString(x.X) + "." + x.Sel.Name
+	case *ast.Ellipsis:
+		return "..." + typeToString(x.Elt)
+	default:
+		return "unknown"
+	}
+}
+
+func buildFuncSignature(ft *ast.FuncType) string {
+	var params, results []string
+
+	if ft.Params != nil {
+		for _, param := range ft.Params.List {
+			paramType := typeToString(param.Type)
+			if len(param.Names) == 0 {
+				params = append(params, paramType)
+			} else {
+				for range param.Names {
+					params = append(params, paramType)
+				}
+			}
+		}
+	}
+
+	if ft.Results != nil {
+		for _, result := range ft.Results.List {
+			resultType := typeToString(result.Type)
+			if len(result.Names) == 0 {
+				results = append(results, resultType)
+			} else {
+				for range result.Names {
+					results = append(results, resultType)
+				}
+			}
+		}
+	}
+
+	sig := "(" + strings.Join(params, ",") + ")"
+	if len(results) > 0 {
+		if len(results) == 1 {
+			sig += results[0]
+		} else {
+			sig += "(" + strings.Join(results, ",") + ")"
+		}
+	}
+
+	return sig
+}
+
+// FormatFunction renders fn in gofuncs' colon-delimited text format.
+func FormatFunction(fn FunctionInfo) string {
+	exported := "n"
+	if fn.Exported {
+		exported = "y"
+	}
+
+	signature := "(" + strings.Join(fn.Params, ",") + ")"
+	if len(fn.Returns) > 0 {
+		if len(fn.Returns) == 1 {
+			signature += fn.Returns[0]
+		} else {
+			signature += "(" + strings.Join(fn.Returns, ",") + ")"
+		}
+	}
+
+	if fn.Type == "m" {
+		return fmt.Sprintf("%s:%d:%s:%s:%s:%s:%s", fn.File, fn.Line, fn.Type, exported, fn.Name, fn.Receiver, signature)
+	}
+	return fmt.Sprintf("%s:%d:%s:%s:%s:%s", fn.File, fn.Line, fn.Type, exported, fn.Name, signature)
+}
@@ -0,0 +1,113 @@
+package main
+
+import "fmt"
+
+// FunctionInfo is the language-agnostic shape a LanguageBackend reports for
+// each function/method it finds, regardless of how it scanned for them.
+type FunctionInfo struct {
+	File     string // path relative to the project directory
+	Name     string
+	Exported bool
+}
+
+// Pattern describes a function a project is expected to have (an
+// entrypoint, a test), used by validateFunctions to decide whether to emit
+// a success or a warning.
+type Pattern struct {
+	Label string
+	Match func(FunctionInfo) bool
+}
+
+// LanguageBackend scans a project for functions in one language and
+// reports which entrypoint patterns it expects to find there.
+type LanguageBackend interface {
+	// Detect reports whether projectDir looks like a project in this
+	// backend's language.
+	Detect(projectDir string) bool
+
+	// Scan walks projectDir and returns the functions it finds. When
+	// onlyFiles is non-nil, functions outside that set (paths relative to
+	// projectDir, forward-slash separated) are excluded, as with
+	// --staged-only.
+	Scan(projectDir string, onlyFiles map[string]bool) ([]FunctionInfo, error)
+
+	// RequiredEntrypoints lists the patterns validateFunctions checks for.
+	RequiredEntrypoints() []Pattern
+}
+
+// backends holds the registered LanguageBackend for each project type.
+// configBuilders holds the matching ProjectConfig builder. Both are keyed
+// by the same name that becomes ProjectConfig.Type.
+var (
+	backends       = map[string]LanguageBackend{}
+	configBuilders = map[string]func(projectDir string) ProjectConfig{}
+)
+
+// backendOrder is the priority order autoDetectProject checks backends in;
+// the first one whose Detect matches wins.
+var backendOrder = []string{"go", "python", "node", "rust", "shell"}
+
+// registerBackend wires a LanguageBackend and its ProjectConfig builder
+// into the registry under name. Backend files call this from init().
+func registerBackend(name string, backend LanguageBackend, configBuilder func(projectDir string) ProjectConfig) {
+	backends[name] = backend
+	configBuilders[name] = configBuilder
+}
+
+// alwaysIgnoredDirs are skipped by every backend's directory walk and by
+// watch mode, regardless of .validatorignore.
+var alwaysIgnoredDirs = []string{".git", "node_modules", "vendor"}
+
+// isAlwaysIgnoredDir reports whether name (a single path element, not a
+// full path) is one of alwaysIgnoredDirs.
+func isAlwaysIgnoredDir(name string) bool {
+	for _, d := range alwaysIgnoredDirs {
+		if name == d {
+			return true
+		}
+	}
+	return false
+}
+
+// validateFunctions scans a project's functions using the LanguageBackend
+// registered for config.Type and checks them against that backend's
+// required entrypoint patterns.
+func validateFunctions(config ProjectConfig, projectDir string, onlyFiles map[string]bool) []ValidationResult {
+	var results []ValidationResult
+
+	backend, ok := backends[config.Type]
+	if !ok {
+		results = append(results, ValidationResult{Type: "warning", Message: fmt.Sprintf("No language backend registered for %q, skipping function validation", config.Type), Item: "functions"})
+		return results
+	}
+
+	functions, err := backend.Scan(projectDir, onlyFiles)
+	if err != nil {
+		results = append(results, ValidationResult{Type: "error", Message: fmt.Sprintf("Error scanning functions: %v", err), Item: "functions"})
+		return results
+	}
+
+	for _, pattern := range backend.RequiredEntrypoints() {
+		if patternMatches(pattern, functions) {
+			results = append(results, ValidationResult{Type: "success", Message: fmt.Sprintf("Found %s", pattern.Label), Item: "functions"})
+		} else {
+			results = append(results, ValidationResult{Type: "warning", Message: fmt.Sprintf("No %s found", pattern.Label), Item: "functions"})
+		}
+	}
+
+	if len(functions) > 0 {
+		results = append(results, ValidationResult{Type: "success", Message: fmt.Sprintf("Analyzed %d functions", len(functions)), Item: "functions"})
+	}
+
+	return results
+}
+
+// patternMatches reports whether any function satisfies pattern.
+func patternMatches(pattern Pattern, functions []FunctionInfo) bool {
+	for _, fn := range functions {
+		if pattern.Match(fn) {
+			return true
+		}
+	}
+	return false
+}
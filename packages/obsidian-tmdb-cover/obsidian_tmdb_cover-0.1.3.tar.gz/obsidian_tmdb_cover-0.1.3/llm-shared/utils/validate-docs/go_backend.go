@@ -0,0 +1,90 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+
+	"llm-shared-utils/gofuncs/scanner"
+)
+
+func init() {
+	registerBackend("go", &goBackend{}, detectGoConfig)
+}
+
+// goBackend scans Go source via the shared gofuncs/scanner package, so it
+// walks the AST the same way `gofuncs` itself does instead of reimplementing
+// a thinner version of the same scan.
+type goBackend struct{}
+
+func (goBackend) Detect(projectDir string) bool {
+	return fileExists(filepath.Join(projectDir, "go.mod"))
+}
+
+func (goBackend) Scan(projectDir string, onlyFiles map[string]bool) ([]FunctionInfo, error) {
+	scanned, _, err := scanner.ExtractAll(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var functions []FunctionInfo
+	for _, fn := range scanned {
+		if fn.Type == "m" {
+			continue
+		}
+
+		rel := filepath.ToSlash(fn.File)
+		if onlyFiles != nil && !onlyFiles[rel] {
+			continue
+		}
+
+		functions = append(functions, FunctionInfo{
+			File:     rel,
+			Name:     fn.Name,
+			Exported: fn.Exported,
+		})
+	}
+
+	return functions, nil
+}
+
+func (goBackend) RequiredEntrypoints() []Pattern {
+	return []Pattern{
+		{
+			Label: "main function",
+			Match: func(fn FunctionInfo) bool { return fn.Name == "main" },
+		},
+		{
+			Label: "test functions",
+			Match: func(fn FunctionInfo) bool { return fn.Exported && strings.HasPrefix(fn.Name, "Test") },
+		},
+	}
+}
+
+// detectGoConfig creates configuration for Go projects.
+func detectGoConfig(projectDir string) ProjectConfig {
+	config := ProjectConfig{Type: "go"}
+
+	// Standard Go directories
+	config.Directories = []string{"cmd", "internal", "pkg"}
+
+	// Add optional directories if they exist
+	optionalDirs := []string{"api", "web", "scripts", "docs", "build", "testdata"}
+	for _, dir := range optionalDirs {
+		if dirExists(filepath.Join(projectDir, dir)) {
+			config.Directories = append(config.Directories, dir)
+		}
+	}
+
+	// Required files
+	config.Files = []string{"go.mod"}
+	if fileExists(filepath.Join(projectDir, "go.sum")) {
+		config.Files = append(config.Files, "go.sum")
+	}
+
+	// Detect build system
+	if fileExists(filepath.Join(projectDir, "Taskfile.yml")) {
+		config.BuildTasks = []string{"build", "test", "lint"}
+	}
+
+	return config
+}
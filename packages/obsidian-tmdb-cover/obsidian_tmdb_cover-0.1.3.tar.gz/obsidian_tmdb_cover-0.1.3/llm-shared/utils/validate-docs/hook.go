@@ -0,0 +1,114 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const hookScript = `#!/bin/sh
+# Installed by validate install-hook. Do not edit directly; re-run
+# "validate install-hook" to regenerate.
+%s --dir %s --fail-on=error --staged-only
+`
+
+// runInstallHook implements `validate install-hook`: it writes a
+// pre-commit hook that runs the currently-running binary (resolved via
+// os.Executable, since the build doesn't install it under a fixed name
+// like "validate") against the staged files. If a hook is already
+// installed (a pre-commit.old backup exists), it refuses to overwrite it
+// until `validate uninstall-hook` restores the backup.
+func runInstallHook(args []string) error {
+	fs := flag.NewFlagSet("install-hook", flag.ExitOnError)
+	repoDir := fs.String("dir", ".", "Repository root to install the hook into")
+	fs.Parse(args)
+
+	hooksDir := filepath.Join(*repoDir, ".git", "hooks")
+	if info, err := os.Stat(hooksDir); err != nil || !info.IsDir() {
+		return fmt.Errorf(".git/hooks not found under %s (is this a git repository?)", *repoDir)
+	}
+
+	hookPath := filepath.Join(hooksDir, "pre-commit")
+	backupPath := hookPath + ".old"
+
+	if fileExists(backupPath) {
+		return fmt.Errorf("%s already exists; run 'validate uninstall-hook' before installing again", backupPath)
+	}
+
+	if fileExists(hookPath) {
+		if err := os.Rename(hookPath, backupPath); err != nil {
+			return fmt.Errorf("backing up existing pre-commit hook: %w", err)
+		}
+		fmt.Printf("Backed up existing pre-commit hook to %s\n", backupPath)
+	}
+
+	absRepoDir, err := filepath.Abs(*repoDir)
+	if err != nil {
+		absRepoDir = *repoDir
+	}
+
+	exePath, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("resolving path to the running validate-docs binary: %w", err)
+	}
+
+	content := fmt.Sprintf(hookScript, exePath, absRepoDir)
+	if err := os.WriteFile(hookPath, []byte(content), 0o755); err != nil {
+		return fmt.Errorf("writing pre-commit hook: %w", err)
+	}
+
+	fmt.Printf("Installed pre-commit hook at %s\n", hookPath)
+	return nil
+}
+
+// runUninstallHook implements `validate uninstall-hook`: it restores the
+// pre-commit.old backup left by install-hook, or removes our hook if there
+// was nothing to restore.
+func runUninstallHook(args []string) error {
+	fs := flag.NewFlagSet("uninstall-hook", flag.ExitOnError)
+	repoDir := fs.String("dir", ".", "Repository root to remove the hook from")
+	fs.Parse(args)
+
+	hookPath := filepath.Join(*repoDir, ".git", "hooks", "pre-commit")
+	backupPath := hookPath + ".old"
+
+	if fileExists(backupPath) {
+		if err := os.Rename(backupPath, hookPath); err != nil {
+			return fmt.Errorf("restoring backed-up pre-commit hook: %w", err)
+		}
+		fmt.Printf("Restored previous pre-commit hook at %s\n", hookPath)
+		return nil
+	}
+
+	if fileExists(hookPath) {
+		if err := os.Remove(hookPath); err != nil {
+			return fmt.Errorf("removing pre-commit hook: %w", err)
+		}
+		fmt.Printf("Removed pre-commit hook at %s\n", hookPath)
+	}
+
+	return nil
+}
+
+// stagedFiles returns the set of paths staged for the next commit, backing
+// --staged-only. git prints them relative to the repository root, so they
+// match projectDir-relative paths only when projectDir is that root.
+func stagedFiles(projectDir string) (map[string]bool, error) {
+	cmd := exec.Command("git", "diff", "--cached", "--name-only", "--diff-filter=ACM")
+	cmd.Dir = projectDir
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("listing staged files: %w", err)
+	}
+
+	files := make(map[string]bool)
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if line != "" {
+			files[filepath.ToSlash(line)] = true
+		}
+	}
+	return files, nil
+}
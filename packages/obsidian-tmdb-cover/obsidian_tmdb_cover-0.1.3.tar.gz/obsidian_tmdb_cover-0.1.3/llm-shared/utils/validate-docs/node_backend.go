@@ -0,0 +1,109 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+func init() {
+	registerBackend("node", &nodeBackend{}, detectNodeConfig)
+}
+
+// nodeFuncRe matches JS/TS function declarations, capturing an optional
+// "export " keyword and the function name. It doesn't catch arrow
+// functions or class methods, mirroring goBackend, which reports functions
+// but not methods.
+var nodeFuncRe = regexp.MustCompile(`\b(export\s+)?function\s+([A-Za-z_$][A-Za-z0-9_$]*)`)
+
+// nodeBackend scans JS/TS sources with a regex; there's no tree-sitter
+// grammar available in this tree to parse them properly.
+type nodeBackend struct{}
+
+func (nodeBackend) Detect(projectDir string) bool {
+	return fileExists(filepath.Join(projectDir, "package.json"))
+}
+
+func (nodeBackend) Scan(projectDir string, onlyFiles map[string]bool) ([]FunctionInfo, error) {
+	var functions []FunctionInfo
+
+	err := filepath.Walk(projectDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if isAlwaysIgnoredDir(info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".js") && !strings.HasSuffix(path, ".ts") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(projectDir, path)
+		if err != nil {
+			rel = path
+		}
+		rel = filepath.ToSlash(rel)
+
+		if onlyFiles != nil && !onlyFiles[rel] {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		for _, match := range nodeFuncRe.FindAllStringSubmatch(string(content), -1) {
+			functions = append(functions, FunctionInfo{
+				File:     rel,
+				Name:     match[2],
+				Exported: match[1] != "",
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return functions, nil
+}
+
+func (nodeBackend) RequiredEntrypoints() []Pattern {
+	return []Pattern{
+		{
+			Label: "test functions",
+			Match: func(fn FunctionInfo) bool { return strings.HasPrefix(strings.ToLower(fn.Name), "test") },
+		},
+	}
+}
+
+// detectNodeConfig creates configuration for Node/TypeScript projects.
+func detectNodeConfig(projectDir string) ProjectConfig {
+	config := ProjectConfig{Type: "node"}
+
+	config.Directories = []string{"src"}
+
+	optionalDirs := []string{"test", "tests", "dist", "public"}
+	for _, dir := range optionalDirs {
+		if dirExists(filepath.Join(projectDir, dir)) {
+			config.Directories = append(config.Directories, dir)
+		}
+	}
+
+	config.Files = []string{"package.json"}
+	if fileExists(filepath.Join(projectDir, "package-lock.json")) {
+		config.Files = append(config.Files, "package-lock.json")
+	}
+
+	if fileExists(filepath.Join(projectDir, "Taskfile.yml")) {
+		config.BuildTasks = []string{"build", "test", "lint"}
+	}
+
+	return config
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+func init() {
+	registerBackend("python", &pythonBackend{}, detectPythonConfig)
+}
+
+// pythonBackend shells out to pyfuncs.py, the same tool the old
+// validatePythonFunctions used, and parses its colon-delimited output into
+// FunctionInfo.
+type pythonBackend struct{}
+
+func (pythonBackend) Detect(projectDir string) bool {
+	return fileExists(filepath.Join(projectDir, "requirements.txt")) || fileExists(filepath.Join(projectDir, "pyproject.toml"))
+}
+
+func (pythonBackend) Scan(projectDir string, onlyFiles map[string]bool) ([]FunctionInfo, error) {
+	pyfuncsPath := findPyfuncs(projectDir)
+	if pyfuncsPath == "" {
+		return nil, nil
+	}
+
+	cmd := exec.Command("python3", pyfuncsPath, "--dir", projectDir)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("running pyfuncs: %w", err)
+	}
+
+	return parsePyfuncsOutput(output, onlyFiles), nil
+}
+
+func (pythonBackend) RequiredEntrypoints() []Pattern {
+	return []Pattern{
+		{
+			Label: "main function",
+			Match: func(fn FunctionInfo) bool { return fn.Name == "main" },
+		},
+		{
+			Label: "test functions",
+			Match: func(fn FunctionInfo) bool { return strings.HasPrefix(fn.Name, "test_") },
+		},
+	}
+}
+
+// findPyfuncs locates pyfuncs.py relative to projectDir, trying the same
+// candidate locations the old validatePythonFunctions did.
+func findPyfuncs(projectDir string) string {
+	locations := []string{
+		filepath.Join(projectDir, "utils", "pyfuncs.py"),
+		filepath.Join(projectDir, "..", "pyfuncs.py"),
+		filepath.Join(projectDir, "..", "..", "utils", "pyfuncs.py"),
+	}
+
+	for _, path := range locations {
+		if fileExists(path) {
+			return path
+		}
+	}
+	return ""
+}
+
+// parsePyfuncsOutput decodes pyfuncs.py's one-line-per-function output,
+// each line shaped "<file>:<kind>:<exported y/n>:<name>:...", into
+// FunctionInfo. When onlyFiles is non-nil, lines for files outside that set
+// are skipped, as with --staged-only.
+func parsePyfuncsOutput(output []byte, onlyFiles map[string]bool) []FunctionInfo {
+	var functions []FunctionInfo
+
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if line == "" {
+			continue
+		}
+
+		fields := strings.SplitN(line, ":", 5)
+		if len(fields) < 4 {
+			continue
+		}
+
+		file := filepath.ToSlash(fields[0])
+		if onlyFiles != nil && !onlyFiles[file] {
+			continue
+		}
+
+		functions = append(functions, FunctionInfo{
+			File:     file,
+			Name:     fields[3],
+			Exported: fields[2] == "y",
+		})
+	}
+
+	return functions
+}
+
+// detectPythonConfig creates configuration for Python projects.
+func detectPythonConfig(projectDir string) ProjectConfig {
+	config := ProjectConfig{Type: "python"}
+
+	// Standard Python directories
+	config.Directories = []string{"src"}
+
+	// Add optional directories if they exist
+	optionalDirs := []string{"tests", "docs", "scripts", "data"}
+	for _, dir := range optionalDirs {
+		if dirExists(filepath.Join(projectDir, dir)) {
+			config.Directories = append(config.Directories, dir)
+		}
+	}
+
+	// Required dependency files
+	if fileExists(filepath.Join(projectDir, "requirements.txt")) {
+		config.Files = append(config.Files, "requirements.txt")
+	}
+	if fileExists(filepath.Join(projectDir, "pyproject.toml")) {
+		config.Files = append(config.Files, "pyproject.toml")
+	}
+
+	// Detect build system
+	if fileExists(filepath.Join(projectDir, "Taskfile.yml")) {
+		config.BuildTasks = []string{"test", "lint"}
+	}
+
+	return config
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+func init() {
+	registerBackend("rust", &rustBackend{}, detectRustConfig)
+}
+
+// rustFuncRe matches Rust function declarations, capturing an optional
+// "pub " visibility modifier and the function name.
+var rustFuncRe = regexp.MustCompile(`\b(pub\s+)?fn\s+([A-Za-z_][A-Za-z0-9_]*)`)
+
+// rustBackend scans Rust sources with a regex rather than a real parser;
+// Cargo projects have no in-tree Go tool to shell out to, so there's
+// nothing to replace here the way goBackend replaces gofuncs.go.
+type rustBackend struct{}
+
+func (rustBackend) Detect(projectDir string) bool {
+	return fileExists(filepath.Join(projectDir, "Cargo.toml"))
+}
+
+func (rustBackend) Scan(projectDir string, onlyFiles map[string]bool) ([]FunctionInfo, error) {
+	var functions []FunctionInfo
+
+	err := filepath.Walk(filepath.Join(projectDir, "src"), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			if isAlwaysIgnoredDir(info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".rs") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(projectDir, path)
+		if err != nil {
+			rel = path
+		}
+		rel = filepath.ToSlash(rel)
+
+		if onlyFiles != nil && !onlyFiles[rel] {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		for _, match := range rustFuncRe.FindAllStringSubmatch(string(content), -1) {
+			functions = append(functions, FunctionInfo{
+				File:     rel,
+				Name:     match[2],
+				Exported: match[1] != "",
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return functions, nil
+}
+
+func (rustBackend) RequiredEntrypoints() []Pattern {
+	return []Pattern{
+		{
+			Label: "main function",
+			Match: func(fn FunctionInfo) bool { return fn.Name == "main" },
+		},
+		{
+			Label: "test functions",
+			Match: func(fn FunctionInfo) bool { return strings.HasPrefix(fn.Name, "test_") },
+		},
+	}
+}
+
+// detectRustConfig creates configuration for Cargo-based Rust projects.
+func detectRustConfig(projectDir string) ProjectConfig {
+	config := ProjectConfig{Type: "rust"}
+
+	config.Directories = []string{"src"}
+
+	optionalDirs := []string{"tests", "examples", "benches"}
+	for _, dir := range optionalDirs {
+		if dirExists(filepath.Join(projectDir, dir)) {
+			config.Directories = append(config.Directories, dir)
+		}
+	}
+
+	config.Files = []string{"Cargo.toml"}
+	if fileExists(filepath.Join(projectDir, "Cargo.lock")) {
+		config.Files = append(config.Files, "Cargo.lock")
+	}
+
+	if fileExists(filepath.Join(projectDir, "Taskfile.yml")) {
+		config.BuildTasks = []string{"build", "test", "lint"}
+	}
+
+	return config
+}
@@ -0,0 +1,484 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// securityFinding is a single issue surfaced by validateSecurity.
+type securityFinding struct {
+	RuleID   string
+	Severity string // "error" or "warning"
+	File     string
+	Line     int
+	Message  string
+}
+
+var (
+	suppressionRe = regexp.MustCompile(`validator:ignore\s+([A-Z0-9-]+)`)
+	credentialRe  = regexp.MustCompile(`(?i)(password|secret|api[_-]?key|token)\s*=\s*"[^"]+"`)
+)
+
+// validateSecurity walks the project's Go source files with go/parser and
+// ast.Inspect (the same approach gofuncs.go uses to extract function
+// signatures) looking for common vulnerability classes: SQL and shell
+// injection, weak crypto, insecure randomness, loose file permissions,
+// open redirects, path traversal, and hardcoded credentials. Every finding
+// is reported with the "security" type and its own Severity; failOn is not
+// applied here (countFailures uses it to decide which findings fail).
+// When onlyFiles is non-nil, only paths present in it (relative to
+// projectDir) are scanned, as with --staged-only.
+func validateSecurity(config ProjectConfig, projectDir, failOn string, onlyFiles map[string]bool) []ValidationResult {
+	var results []ValidationResult
+	if config.Type != "go" {
+		return results
+	}
+
+	findings, err := scanSecurity(projectDir, onlyFiles)
+	if err != nil {
+		results = append(results, ValidationResult{Type: "error", Message: fmt.Sprintf("Error scanning for security issues: %v", err), Item: "security"})
+		return results
+	}
+
+	if len(findings) == 0 {
+		results = append(results, ValidationResult{Type: "success", Message: "No security issues found", Item: "security"})
+		return results
+	}
+
+	for _, f := range findings {
+		results = append(results, ValidationResult{
+			Type:     "security",
+			Message:  fmt.Sprintf("%s:%d: %s", f.File, f.Line, f.Message),
+			Item:     f.File,
+			RuleID:   f.RuleID,
+			Severity: f.Severity,
+		})
+	}
+
+	return results
+}
+
+// scanSecurity applies the AST-based rules to every non-vendor .go file
+// under projectDir, or only to onlyFiles when it is non-nil.
+func scanSecurity(projectDir string, onlyFiles map[string]bool) ([]securityFinding, error) {
+	var findings []securityFinding
+	fset := token.NewFileSet()
+
+	err := filepath.Walk(projectDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if isAlwaysIgnoredDir(info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		relPath, _ := filepath.Rel(projectDir, path)
+		if onlyFiles != nil && !onlyFiles[filepath.ToSlash(relPath)] {
+			return nil
+		}
+
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		node, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+		if err != nil {
+			// A file that fails to parse is reported by the structure scan,
+			// not the security scan.
+			return nil
+		}
+
+		findings = append(findings, scanFile(fset, relPath, node)...)
+		return nil
+	})
+
+	return findings, err
+}
+
+// scanFile runs every rule against a single parsed file. It first collects
+// one hop of local-variable taint (collectTaintedVars): which identifiers
+// are bound to a concatenation, a request-derived call, or a filepath.Join
+// result. Rules then check call arguments against those sets in addition to
+// the immediate argument expression, so `query := "..." + userID;
+// db.Query(query)` is caught the same way `db.Query("..." + userID)` is.
+func scanFile(fset *token.FileSet, relPath string, node *ast.File) []securityFinding {
+	ignored := suppressedRules(node)
+	isTestFile := strings.HasSuffix(relPath, "_test.go")
+	randImportPath := importPath(node, "rand")
+	concatTainted, requestTainted, joinTainted := collectTaintedVars(node)
+
+	report := func(findings *[]securityFinding, pos token.Pos, ruleID, severity, message string) {
+		if ignored[ruleID] {
+			return
+		}
+		*findings = append(*findings, securityFinding{
+			RuleID:   ruleID,
+			Severity: severity,
+			File:     relPath,
+			Line:     fset.Position(pos).Line,
+			Message:  message,
+		})
+	}
+
+	var findings []securityFinding
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.CallExpr:
+			sel, ok := x.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			recv := identName(sel.X)
+			method := sel.Sel.Name
+
+			switch {
+			case (method == "Query" || method == "QueryContext" || method == "Exec" || method == "ExecContext") && hasConcatArg(x.Args, concatTainted):
+				report(&findings, x.Pos(), "SEC-SQL-01", "error", fmt.Sprintf("%s.%s built from string concatenation is vulnerable to SQL injection", recv, method))
+
+			case recv == "exec" && method == "Command" && isShellInjection(x.Args, concatTainted):
+				report(&findings, x.Pos(), "SEC-CMD-01", "error", "exec.Command(\"sh\"/\"bash\", \"-c\", ...) built from string concatenation is vulnerable to shell injection")
+
+			case (recv == "md5" || recv == "sha1") && method == "New" && !isTestFile:
+				report(&findings, x.Pos(), "SEC-CRYPTO-01", "warning", fmt.Sprintf("%s.New is a weak hash for security-sensitive use; prefer crypto/sha256 or stronger", recv))
+
+			case recv == "rand" && randImportPath == "math/rand" && !isTestFile:
+				report(&findings, x.Pos(), "SEC-CRYPTO-02", "warning", "math/rand is not safe for security-sensitive randomness; use crypto/rand")
+
+			case (recv == "ioutil" || recv == "os") && method == "WriteFile" && hasLoosePermissions(x.Args):
+				report(&findings, x.Pos(), "SEC-PERM-01", "warning", fmt.Sprintf("%s.WriteFile uses an overly permissive file mode", recv))
+
+			case recv == "http" && method == "Redirect" && len(x.Args) >= 3 && isUnvalidatedRedirectTarget(x.Args[2], requestTainted):
+				report(&findings, x.Pos(), "SEC-REDIRECT-01", "error", "http.Redirect target comes directly from request input without an allow-list check")
+
+			case method == "Join" && recv == "filepath" && hasRequestDerivedArg(x.Args, requestTainted):
+				report(&findings, x.Pos(), "SEC-PATH-01", "error", "filepath.Join with a request-derived argument is vulnerable to path traversal; validate with filepath.Clean and a prefix check")
+
+			case isFileSink(recv, method) && hasPathTaintedArg(x.Args, concatTainted, requestTainted, joinTainted):
+				report(&findings, x.Pos(), "SEC-PATH-01", "error", fmt.Sprintf("%s.%s receives a path built from concatenation or filepath.Join without validation; vulnerable to path traversal", recv, method))
+			}
+
+		case *ast.GenDecl:
+			if x.Tok == token.CONST {
+				findings = append(findings, scanHardcodedCredentials(fset, relPath, x, ignored)...)
+			}
+		}
+		return true
+	})
+
+	return findings
+}
+
+// collectTaintedVars walks node once, collecting the identifiers bound (via
+// `:=`, `=`, or a var declaration) directly to a string concatenation, a
+// request-derived call, or a filepath.Join call. This is deliberately one
+// hop only: it classifies each variable from its own RHS, not from values
+// that later flow through another variable.
+func collectTaintedVars(node *ast.File) (concatTainted, requestTainted, joinTainted map[string]bool) {
+	concatTainted = map[string]bool{}
+	requestTainted = map[string]bool{}
+	joinTainted = map[string]bool{}
+
+	record := func(name string, rhs ast.Expr) {
+		if isConcat(rhs, nil) {
+			concatTainted[name] = true
+		}
+		if containsRequestInput(rhs, nil) {
+			requestTainted[name] = true
+		}
+		if call, ok := rhs.(*ast.CallExpr); ok {
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok && identName(sel.X) == "filepath" && sel.Sel.Name == "Join" {
+				joinTainted[name] = true
+			}
+		}
+	}
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range x.Lhs {
+				if i >= len(x.Rhs) {
+					continue
+				}
+				if ident, ok := lhs.(*ast.Ident); ok {
+					record(ident.Name, x.Rhs[i])
+				}
+			}
+		case *ast.ValueSpec:
+			for i, name := range x.Names {
+				if i >= len(x.Values) {
+					continue
+				}
+				record(name.Name, x.Values[i])
+			}
+		}
+		return true
+	})
+
+	return concatTainted, requestTainted, joinTainted
+}
+
+// scanHardcodedCredentials flags const declarations whose name looks like a
+// credential and whose value is a non-empty string literal.
+func scanHardcodedCredentials(fset *token.FileSet, relPath string, decl *ast.GenDecl, ignored map[string]bool) []securityFinding {
+	var findings []securityFinding
+	if ignored["SEC-SECRET-01"] {
+		return findings
+	}
+
+	for _, spec := range decl.Specs {
+		vspec, ok := spec.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+		for i, name := range vspec.Names {
+			if i >= len(vspec.Values) {
+				continue
+			}
+			lit, ok := vspec.Values[i].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				continue
+			}
+			value := strings.Trim(lit.Value, `"`+"`")
+			if value == "" {
+				continue
+			}
+			if credentialRe.MatchString(name.Name + " = " + lit.Value) {
+				findings = append(findings, securityFinding{
+					RuleID:   "SEC-SECRET-01",
+					Severity: "error",
+					File:     relPath,
+					Line:     fset.Position(vspec.Pos()).Line,
+					Message:  fmt.Sprintf("const %s looks like a hardcoded credential", name.Name),
+				})
+			}
+		}
+	}
+
+	return findings
+}
+
+// suppressedRules collects rule IDs disabled via a "//validator:ignore
+// RULE-ID" comment anywhere in the file.
+func suppressedRules(node *ast.File) map[string]bool {
+	ignored := make(map[string]bool)
+	for _, group := range node.Comments {
+		for _, c := range group.List {
+			if m := suppressionRe.FindStringSubmatch(c.Text); m != nil {
+				ignored[m[1]] = true
+			}
+		}
+	}
+	return ignored
+}
+
+// importPath returns the import path registered under the given local
+// package name (either its alias or the last path element), or "" if none.
+func importPath(node *ast.File, name string) string {
+	for _, imp := range node.Imports {
+		path := strings.Trim(imp.Path.Value, `"`)
+		if imp.Name != nil {
+			if imp.Name.Name == name {
+				return path
+			}
+			continue
+		}
+		if path == name || strings.HasSuffix(path, "/"+name) {
+			return path
+		}
+	}
+	return ""
+}
+
+func identName(expr ast.Expr) string {
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// hasConcatArg reports whether any argument is a string built with "+",
+// either inline or (via concatTainted) one hop through a local variable.
+func hasConcatArg(args []ast.Expr, concatTainted map[string]bool) bool {
+	for _, arg := range args {
+		if isConcat(arg, concatTainted) {
+			return true
+		}
+	}
+	return false
+}
+
+// isConcat reports whether expr is a non-literal "+" concatenation, or an
+// identifier bound to one per concatTainted.
+func isConcat(expr ast.Expr, concatTainted map[string]bool) bool {
+	switch x := expr.(type) {
+	case *ast.BinaryExpr:
+		if x.Op != token.ADD {
+			return false
+		}
+		return !isLiteral(x.X) || !isLiteral(x.Y)
+	case *ast.Ident:
+		return concatTainted[x.Name]
+	default:
+		return false
+	}
+}
+
+func isLiteral(expr ast.Expr) bool {
+	switch x := expr.(type) {
+	case *ast.BasicLit:
+		return true
+	case *ast.BinaryExpr:
+		return isLiteral(x.X) && isLiteral(x.Y)
+	default:
+		return false
+	}
+}
+
+// isShellInjection reports exec.Command("sh"|"bash", "-c", <concat>).
+func isShellInjection(args []ast.Expr, concatTainted map[string]bool) bool {
+	if len(args) < 3 {
+		return false
+	}
+	shell, ok := args[0].(*ast.BasicLit)
+	if !ok || (shell.Value != `"sh"` && shell.Value != `"bash"`) {
+		return false
+	}
+	flag, ok := args[1].(*ast.BasicLit)
+	if !ok || flag.Value != `"-c"` {
+		return false
+	}
+	return isConcat(args[2], concatTainted)
+}
+
+// hasLoosePermissions reports whether the final argument is an integer mode
+// literal of 0o666 or looser.
+func hasLoosePermissions(args []ast.Expr) bool {
+	if len(args) == 0 {
+		return false
+	}
+	lit, ok := args[len(args)-1].(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return false
+	}
+	mode, err := strconv.ParseInt(lit.Value, 0, 64)
+	if err != nil {
+		return false
+	}
+	return mode&0o666 == 0o666
+}
+
+// isUnvalidatedRedirectTarget reports whether expr is (or chains through) a
+// call to r.URL.Query().Get or r.FormValue, or an identifier bound to one
+// per requestTainted.
+func isUnvalidatedRedirectTarget(expr ast.Expr, requestTainted map[string]bool) bool {
+	if ident, ok := expr.(*ast.Ident); ok {
+		return requestTainted[ident.Name]
+	}
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	if sel.Sel.Name == "FormValue" {
+		return true
+	}
+	if sel.Sel.Name == "Get" {
+		if inner, ok := sel.X.(*ast.CallExpr); ok {
+			if innerSel, ok := inner.Fun.(*ast.SelectorExpr); ok && innerSel.Sel.Name == "Query" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasRequestDerivedArg reports whether any argument references request
+// input (r.FormValue(...), r.URL.Query().Get(...)) rather than only
+// literals, either inline or one hop through requestTainted.
+func hasRequestDerivedArg(args []ast.Expr, requestTainted map[string]bool) bool {
+	for _, arg := range args {
+		if containsRequestInput(arg, requestTainted) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsRequestInput(expr ast.Expr, requestTainted map[string]bool) bool {
+	switch x := expr.(type) {
+	case *ast.CallExpr:
+		if isUnvalidatedRedirectTarget(x, requestTainted) {
+			return true
+		}
+		for _, arg := range x.Args {
+			if containsRequestInput(arg, requestTainted) {
+				return true
+			}
+		}
+		return false
+	case *ast.BinaryExpr:
+		return containsRequestInput(x.X, requestTainted) || containsRequestInput(x.Y, requestTainted)
+	case *ast.Ident:
+		return requestTainted[x.Name]
+	default:
+		return false
+	}
+}
+
+// isFileSink reports whether recv.method reads or opens a file given a path
+// argument.
+func isFileSink(recv, method string) bool {
+	switch recv {
+	case "os":
+		return method == "Open" || method == "Create" || method == "OpenFile" || method == "ReadFile"
+	case "ioutil":
+		return method == "ReadFile"
+	}
+	return false
+}
+
+// hasPathTaintedArg reports whether any argument is an untrusted path: a
+// concatenation, a filepath.Join call or result, or request-derived input,
+// either inline or one hop through a local variable.
+func hasPathTaintedArg(args []ast.Expr, concatTainted, requestTainted, joinTainted map[string]bool) bool {
+	for _, arg := range args {
+		if pathTainted(arg, concatTainted, requestTainted, joinTainted) {
+			return true
+		}
+	}
+	return false
+}
+
+func pathTainted(expr ast.Expr, concatTainted, requestTainted, joinTainted map[string]bool) bool {
+	switch x := expr.(type) {
+	case *ast.Ident:
+		return concatTainted[x.Name] || requestTainted[x.Name] || joinTainted[x.Name]
+	case *ast.BinaryExpr:
+		return isConcat(x, concatTainted)
+	case *ast.CallExpr:
+		if sel, ok := x.Fun.(*ast.SelectorExpr); ok && identName(sel.X) == "filepath" && sel.Sel.Name == "Join" {
+			return true
+		}
+		return containsRequestInput(x, requestTainted)
+	default:
+		return false
+	}
+}
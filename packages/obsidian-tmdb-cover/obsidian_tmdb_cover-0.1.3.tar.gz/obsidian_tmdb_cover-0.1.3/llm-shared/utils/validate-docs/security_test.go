@@ -0,0 +1,245 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// parseSource parses src as a standalone Go file for use with scanFile.
+func parseSource(t *testing.T, src string) (*token.FileSet, *ast.File) {
+	t.Helper()
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, "test.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("parsing fixture: %v", err)
+	}
+	return fset, node
+}
+
+// ruleIDs collects the distinct RuleID values from findings.
+func ruleIDs(findings []securityFinding) map[string]bool {
+	ids := make(map[string]bool)
+	for _, f := range findings {
+		ids[f.RuleID] = true
+	}
+	return ids
+}
+
+func TestScanFileRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		wantRule string
+		wantNone bool
+	}{
+		{
+			name: "sql injection inline concatenation",
+			src: `package p
+func f(db *sql.DB, userID string) {
+	db.Query("SELECT * FROM users WHERE id = " + userID)
+}`,
+			wantRule: "SEC-SQL-01",
+		},
+		{
+			name: "sql injection one-hop through local variable",
+			src: `package p
+func f(db *sql.DB, userID string) {
+	query := "SELECT * FROM users WHERE id = " + userID
+	db.Query(query)
+}`,
+			wantRule: "SEC-SQL-01",
+		},
+		{
+			name: "sql query with only literals is not flagged",
+			src: `package p
+func f(db *sql.DB) {
+	db.Query("SELECT * FROM users")
+}`,
+			wantNone: true,
+		},
+		{
+			name: "shell injection via exec.Command",
+			src: `package p
+func f(userInput string) {
+	cmd := "echo " + userInput
+	exec.Command("sh", "-c", cmd)
+}`,
+			wantRule: "SEC-CMD-01",
+		},
+		{
+			name: "weak hash md5",
+			src: `package p
+func f() {
+	md5.New()
+}`,
+			wantRule: "SEC-CRYPTO-01",
+		},
+		{
+			name: "insecure math/rand",
+			src: `package p
+import "math/rand"
+func f() {
+	rand.Int()
+}`,
+			wantRule: "SEC-CRYPTO-02",
+		},
+		{
+			name: "loose file permissions",
+			src: `package p
+func f() {
+	os.WriteFile("out.txt", data, 0o666)
+}`,
+			wantRule: "SEC-PERM-01",
+		},
+		{
+			name: "open redirect from request input",
+			src: `package p
+func f(w http.ResponseWriter, r *http.Request) {
+	http.Redirect(w, r, r.FormValue("next"), 302)
+}`,
+			wantRule: "SEC-REDIRECT-01",
+		},
+		{
+			name: "open redirect one-hop through local variable",
+			src: `package p
+func f(w http.ResponseWriter, r *http.Request) {
+	next := r.FormValue("next")
+	http.Redirect(w, r, next, 302)
+}`,
+			wantRule: "SEC-REDIRECT-01",
+		},
+		{
+			name: "path traversal via filepath.Join with request input",
+			src: `package p
+func f(r *http.Request) {
+	filepath.Join("/uploads", r.URL.Query().Get("name"))
+}`,
+			wantRule: "SEC-PATH-01",
+		},
+		{
+			name: "path traversal via file sink with joined path one hop",
+			src: `package p
+func f(filename string) {
+	fullPath := filepath.Join("/uploads", filename)
+	os.Open(fullPath)
+}`,
+			wantRule: "SEC-PATH-01",
+		},
+		{
+			name: "path traversal via file sink with concatenated path one hop",
+			src: `package p
+func f(basePath, userPath string) {
+	fullPath := basePath + "/" + userPath
+	os.ReadFile(fullPath)
+}`,
+			wantRule: "SEC-PATH-01",
+		},
+		{
+			name: "hardcoded credential constant",
+			src: `package p
+const apiKey = "sk-abc123def456"`,
+			wantRule: "SEC-SECRET-01",
+		},
+		{
+			name: "empty credential-looking constant is not flagged",
+			src: `package p
+const apiKey = ""`,
+			wantNone: true,
+		},
+		{
+			name: "suppressed rule via validator:ignore comment",
+			src: `package p
+//validator:ignore SEC-SQL-01
+func f(db *sql.DB, userID string) {
+	db.Query("SELECT * FROM users WHERE id = " + userID)
+}`,
+			wantNone: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fset, node := parseSource(t, tt.src)
+			findings := scanFile(fset, "test.go", node)
+			ids := ruleIDs(findings)
+
+			if tt.wantNone {
+				if len(findings) != 0 {
+					t.Fatalf("expected no findings, got %v", findings)
+				}
+				return
+			}
+
+			if !ids[tt.wantRule] {
+				t.Fatalf("expected finding %s, got %v", tt.wantRule, findings)
+			}
+		})
+	}
+}
+
+func TestScanSecurityRespectsOnlyFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	vulnerable := `package p
+func f(db *sql.DB, userID string) {
+	db.Query("SELECT * FROM users WHERE id = " + userID)
+}
+`
+	safe := `package p
+func g() {}
+`
+	if err := os.WriteFile(filepath.Join(dir, "vulnerable.go"), []byte(vulnerable), 0o644); err != nil {
+		t.Fatalf("writing fixture: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "safe.go"), []byte(safe), 0o644); err != nil {
+		t.Fatalf("writing fixture: %v", err)
+	}
+
+	findings, err := scanSecurity(dir, map[string]bool{"safe.go": true})
+	if err != nil {
+		t.Fatalf("scanSecurity: %v", err)
+	}
+	if len(findings) != 0 {
+		t.Fatalf("expected no findings when onlyFiles excludes the vulnerable file, got %v", findings)
+	}
+
+	findings, err = scanSecurity(dir, nil)
+	if err != nil {
+		t.Fatalf("scanSecurity: %v", err)
+	}
+	if !ruleIDs(findings)["SEC-SQL-01"] {
+		t.Fatalf("expected SEC-SQL-01 when scanning the whole directory, got %v", findings)
+	}
+}
+
+func TestScanSecuritySkipsUnparseableFile(t *testing.T) {
+	dir := t.TempDir()
+
+	vulnerable := `package p
+func f(db *sql.DB, userID string) {
+	db.Query("SELECT * FROM users WHERE id = " + userID)
+}
+`
+	broken := `package p
+
+this is not valid go {{{
+`
+	if err := os.WriteFile(filepath.Join(dir, "vulnerable.go"), []byte(vulnerable), 0o644); err != nil {
+		t.Fatalf("writing fixture: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "broken.go"), []byte(broken), 0o644); err != nil {
+		t.Fatalf("writing fixture: %v", err)
+	}
+
+	findings, err := scanSecurity(dir, nil)
+	if err != nil {
+		t.Fatalf("scanSecurity should skip the unparseable file rather than error, got: %v", err)
+	}
+	if !ruleIDs(findings)["SEC-SQL-01"] {
+		t.Fatalf("expected SEC-SQL-01 from the valid file despite the broken one, got %v", findings)
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+func init() {
+	registerBackend("shell", &shellBackend{}, detectShellConfig)
+}
+
+// shellFuncRe matches both shell function declaration styles: "function
+// foo" and "foo()".
+var shellFuncRe = regexp.MustCompile(`(?m)^\s*(?:function\s+([A-Za-z_][A-Za-z0-9_]*)|([A-Za-z_][A-Za-z0-9_]*)\s*\(\s*\))`)
+
+// shellBackend scans .sh files with a regex. Shell has no exported/private
+// distinction, so every function it finds is reported as Exported.
+type shellBackend struct{}
+
+func (shellBackend) Detect(projectDir string) bool {
+	matches, err := filepath.Glob(filepath.Join(projectDir, "*.sh"))
+	return err == nil && len(matches) > 0
+}
+
+func (shellBackend) Scan(projectDir string, onlyFiles map[string]bool) ([]FunctionInfo, error) {
+	var functions []FunctionInfo
+
+	err := filepath.Walk(projectDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if isAlwaysIgnoredDir(info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".sh") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(projectDir, path)
+		if err != nil {
+			rel = path
+		}
+		rel = filepath.ToSlash(rel)
+
+		if onlyFiles != nil && !onlyFiles[rel] {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		for _, match := range shellFuncRe.FindAllStringSubmatch(string(content), -1) {
+			name := match[1]
+			if name == "" {
+				name = match[2]
+			}
+			functions = append(functions, FunctionInfo{File: rel, Name: name, Exported: true})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return functions, nil
+}
+
+func (shellBackend) RequiredEntrypoints() []Pattern {
+	return []Pattern{
+		{
+			Label: "main function",
+			Match: func(fn FunctionInfo) bool { return fn.Name == "main" },
+		},
+	}
+}
+
+// detectShellConfig creates configuration for shell-script projects.
+func detectShellConfig(projectDir string) ProjectConfig {
+	config := ProjectConfig{Type: "shell"}
+
+	optionalDirs := []string{"scripts", "lib", "tests"}
+	for _, dir := range optionalDirs {
+		if dirExists(filepath.Join(projectDir, dir)) {
+			config.Directories = append(config.Directories, dir)
+		}
+	}
+
+	if fileExists(filepath.Join(projectDir, "Taskfile.yml")) {
+		config.BuildTasks = []string{"test", "lint"}
+	}
+
+	return config
+}
@@ -4,31 +4,55 @@ import (
 	"flag"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 )
 
-// ProjectConfig represents auto-detected project configuration
+// ProjectConfig represents auto-detected project configuration. Type is
+// the key under which a LanguageBackend is registered.
 type ProjectConfig struct {
 	Name        string
 	Type        string
 	Directories []string
 	Files       []string
 	BuildTasks  []string
-	HasMain     bool
-	HasTests    bool
 }
 
 // ValidationResult holds the results of validation checks
 type ValidationResult struct {
-	Type    string // "error", "warning", "success"
+	Type    string // "error", "warning", "success", "security"
 	Message string
 	Item    string
+
+	// RuleID and Severity are populated for Type == "security" findings
+	// produced by validateSecurity; Severity is "error" or "warning".
+	RuleID   string
+	Severity string
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		var err error
+		switch os.Args[1] {
+		case "watch":
+			err = runWatch(os.Args[2:])
+		case "install-hook":
+			err = runInstallHook(os.Args[2:])
+		case "uninstall-hook":
+			err = runUninstallHook(os.Args[2:])
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if os.Args[1] == "watch" || os.Args[1] == "install-hook" || os.Args[1] == "uninstall-hook" {
+			return
+		}
+	}
+
 	projectDir := flag.String("dir", ".", "Project directory to validate")
+	failOn := flag.String("fail-on", "error", "Minimum security finding severity that fails validation (error, warning, none)")
+	stagedOnly := flag.Bool("staged-only", false, "Restrict AST scans to files staged in git (git diff --cached --name-only --diff-filter=ACM)")
 	flag.Parse()
 
 	config, err := autoDetectProject(*projectDir)
@@ -37,106 +61,48 @@ func main() {
 		os.Exit(1)
 	}
 
+	var onlyFiles map[string]bool
+	if *stagedOnly {
+		onlyFiles, err = stagedFiles(*projectDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
 	fmt.Printf("Detected %s project: %s\n", config.Type, config.Name)
 
-	results := validateProject(config, *projectDir)
+	results := validateProject(config, *projectDir, *failOn, onlyFiles)
 	printResults(results)
 
-	errors := countErrors(results)
-	if errors > 0 {
-		fmt.Printf("\nValidation failed with %d errors\n", errors)
+	failures := countFailures(results, *failOn)
+	if failures > 0 {
+		fmt.Printf("\nValidation failed with %d errors\n", failures)
 		os.Exit(1)
 	} else {
 		fmt.Println("\nValidation passed!")
 	}
 }
 
-// autoDetectProject detects project type and creates smart configuration
+// autoDetectProject detects project type by asking each registered
+// LanguageBackend (in backendOrder priority) whether it recognizes
+// projectDir, then builds a ProjectConfig with that backend's config
+// builder.
 func autoDetectProject(projectDir string) (ProjectConfig, error) {
-	var config ProjectConfig
-	config.Name = filepath.Base(projectDir)
-
-	// Detect project type
-	if fileExists(filepath.Join(projectDir, "go.mod")) {
-		config = detectGoProject(projectDir)
-	} else if fileExists(filepath.Join(projectDir, "requirements.txt")) || fileExists(filepath.Join(projectDir, "pyproject.toml")) {
-		config = detectPythonProject(projectDir)
-	} else {
-		return config, fmt.Errorf("unable to detect project type (no go.mod, requirements.txt, or pyproject.toml found)")
-	}
-
-	config.Name = filepath.Base(projectDir)
-	return config, nil
-}
-
-// detectGoProject creates configuration for Go projects
-func detectGoProject(projectDir string) ProjectConfig {
-	config := ProjectConfig{Type: "go"}
-
-	// Standard Go directories
-	config.Directories = []string{"cmd", "internal", "pkg"}
-
-	// Add optional directories if they exist
-	optionalDirs := []string{"api", "web", "scripts", "docs", "build", "testdata"}
-	for _, dir := range optionalDirs {
-		if dirExists(filepath.Join(projectDir, dir)) {
-			config.Directories = append(config.Directories, dir)
+	for _, name := range backendOrder {
+		if !backends[name].Detect(projectDir) {
+			continue
 		}
+		config := configBuilders[name](projectDir)
+		config.Name = filepath.Base(projectDir)
+		return config, nil
 	}
 
-	// Required files
-	config.Files = []string{"go.mod"}
-	if fileExists(filepath.Join(projectDir, "go.sum")) {
-		config.Files = append(config.Files, "go.sum")
-	}
-
-	// Detect build system
-	if fileExists(filepath.Join(projectDir, "Taskfile.yml")) {
-		config.BuildTasks = []string{"build", "test", "lint"}
-	}
-
-	config.HasMain = true
-	config.HasTests = true
-
-	return config
-}
-
-// detectPythonProject creates configuration for Python projects
-func detectPythonProject(projectDir string) ProjectConfig {
-	config := ProjectConfig{Type: "python"}
-
-	// Standard Python directories
-	config.Directories = []string{"src"}
-
-	// Add optional directories if they exist
-	optionalDirs := []string{"tests", "docs", "scripts", "data"}
-	for _, dir := range optionalDirs {
-		if dirExists(filepath.Join(projectDir, dir)) {
-			config.Directories = append(config.Directories, dir)
-		}
-	}
-
-	// Required dependency files
-	if fileExists(filepath.Join(projectDir, "requirements.txt")) {
-		config.Files = append(config.Files, "requirements.txt")
-	}
-	if fileExists(filepath.Join(projectDir, "pyproject.toml")) {
-		config.Files = append(config.Files, "pyproject.toml")
-	}
-
-	// Detect build system
-	if fileExists(filepath.Join(projectDir, "Taskfile.yml")) {
-		config.BuildTasks = []string{"test", "lint"}
-	}
-
-	config.HasMain = true
-	config.HasTests = true
-
-	return config
+	return ProjectConfig{}, fmt.Errorf("unable to detect project type (no registered language backend recognized %s)", projectDir)
 }
 
 // validateProject performs all validation checks
-func validateProject(config ProjectConfig, projectDir string) []ValidationResult {
+func validateProject(config ProjectConfig, projectDir, failOn string, onlyFiles map[string]bool) []ValidationResult {
 	var results []ValidationResult
 
 	fmt.Printf("Validating %s project structure...\n", config.Name)
@@ -153,7 +119,10 @@ func validateProject(config ProjectConfig, projectDir string) []ValidationResult
 	}
 
 	// Validate functions using existing tools
-	results = append(results, validateFunctions(config, projectDir)...)
+	results = append(results, validateFunctions(config, projectDir, onlyFiles)...)
+
+	// Scan for common vulnerability classes
+	results = append(results, validateSecurity(config, projectDir, failOn, onlyFiles)...)
 
 	return results
 }
@@ -165,14 +134,14 @@ func validateDirectories(dirs []string, projectDir string) []ValidationResult {
 	for _, dir := range dirs {
 		dirPath := filepath.Join(projectDir, dir)
 		if dirExists(dirPath) {
-			results = append(results, ValidationResult{"success", fmt.Sprintf("Directory exists: %s", dir), dir})
+			results = append(results, ValidationResult{Type: "success", Message: fmt.Sprintf("Directory exists: %s", dir), Item: dir})
 		} else {
 			// Only error for standard directories, warn for optional ones
 			msgType := "warning"
 			if isStandardDirectory(dir) {
 				msgType = "error"
 			}
-			results = append(results, ValidationResult{msgType, fmt.Sprintf("Directory missing: %s", dir), dir})
+			results = append(results, ValidationResult{Type: msgType, Message: fmt.Sprintf("Directory missing: %s", dir), Item: dir})
 		}
 	}
 
@@ -186,9 +155,9 @@ func validateFiles(files []string, projectDir string) []ValidationResult {
 	for _, file := range files {
 		filePath := filepath.Join(projectDir, file)
 		if fileExists(filePath) {
-			results = append(results, ValidationResult{"success", fmt.Sprintf("File exists: %s", file), file})
+			results = append(results, ValidationResult{Type: "success", Message: fmt.Sprintf("File exists: %s", file), Item: file})
 		} else {
-			results = append(results, ValidationResult{"error", fmt.Sprintf("Required file missing: %s", file), file})
+			results = append(results, ValidationResult{Type: "error", Message: fmt.Sprintf("Required file missing: %s", file), Item: file})
 		}
 	}
 
@@ -202,7 +171,7 @@ func validateBuildSystem(tasks []string, projectDir string) []ValidationResult {
 	if fileExists(filepath.Join(projectDir, "Taskfile.yml")) {
 		results = append(results, validateTaskfile(tasks, projectDir)...)
 	} else {
-		results = append(results, ValidationResult{"warning", "No Taskfile.yml found (required for build system)", "build"})
+		results = append(results, ValidationResult{Type: "warning", Message: "No Taskfile.yml found (required for build system)", Item: "build"})
 	}
 
 	return results
@@ -215,7 +184,7 @@ func validateTaskfile(expectedTasks []string, projectDir string) []ValidationRes
 
 	content, err := os.ReadFile(taskfilePath)
 	if err != nil {
-		results = append(results, ValidationResult{"error", fmt.Sprintf("Error reading Taskfile.yml: %v", err), "Taskfile.yml"})
+		results = append(results, ValidationResult{Type: "error", Message: fmt.Sprintf("Error reading Taskfile.yml: %v", err), Item: "Taskfile.yml"})
 		return results
 	}
 
@@ -223,178 +192,12 @@ func validateTaskfile(expectedTasks []string, projectDir string) []ValidationRes
 	for _, task := range expectedTasks {
 		taskPattern := task + ":"
 		if strings.Contains(taskfileContent, taskPattern) {
-			results = append(results, ValidationResult{"success", fmt.Sprintf("Task found: %s", task), "Taskfile.yml"})
-		} else {
-			results = append(results, ValidationResult{"warning", fmt.Sprintf("Task missing: %s", task), "Taskfile.yml"})
-		}
-	}
-
-	return results
-}
-
-// validateFunctions uses gofuncs.go or pyfuncs.py to validate function patterns
-func validateFunctions(config ProjectConfig, projectDir string) []ValidationResult {
-	var results []ValidationResult
-
-	switch config.Type {
-	case "go":
-		results = append(results, validateGoFunctions(config, projectDir)...)
-	case "python":
-		results = append(results, validatePythonFunctions(config, projectDir)...)
-	}
-
-	return results
-}
-
-// validateGoFunctions uses gofuncs.go to validate Go function patterns
-func validateGoFunctions(config ProjectConfig, projectDir string) []ValidationResult {
-	var results []ValidationResult
-
-	// Find gofuncs.go - try multiple locations
-	var gofuncsPath string
-	locations := []string{
-		filepath.Join(projectDir, "utils", "gofuncs", "gofuncs.go"),
-		filepath.Join(projectDir, "..", "gofuncs", "gofuncs.go"),
-		filepath.Join(projectDir, "..", "..", "utils", "gofuncs", "gofuncs.go"),
-	}
-
-	for _, path := range locations {
-		if fileExists(path) {
-			gofuncsPath = path
-			break
-		}
-	}
-
-	if gofuncsPath == "" {
-		results = append(results, ValidationResult{"warning", "gofuncs.go not found, skipping function validation", "functions"})
-		return results
-	}
-
-	// Run gofuncs.go
-	cmd := exec.Command("go", "run", gofuncsPath, "-dir", projectDir)
-	output, err := cmd.Output()
-	if err != nil {
-		results = append(results, ValidationResult{"error", fmt.Sprintf("Error running gofuncs: %v", err), "functions"})
-		return results
-	}
-
-	functions := strings.Split(strings.TrimSpace(string(output)), "\n")
-	if len(functions) == 1 && functions[0] == "" {
-		functions = []string{}
-	}
-
-	// Check for main functions if required
-	if config.HasMain {
-		hasMain := false
-		for _, fn := range functions {
-			if strings.Contains(fn, ":f:y:main:") {
-				hasMain = true
-				break
-			}
-		}
-		if hasMain {
-			results = append(results, ValidationResult{"success", "Found main function", "functions"})
-		} else {
-			results = append(results, ValidationResult{"warning", "No main function found", "functions"})
-		}
-	}
-
-	// Check for test functions
-	if config.HasTests {
-		hasTests := false
-		for _, fn := range functions {
-			if strings.Contains(fn, ":f:y:Test") {
-				hasTests = true
-				break
-			}
-		}
-		if hasTests {
-			results = append(results, ValidationResult{"success", "Found test functions", "functions"})
+			results = append(results, ValidationResult{Type: "success", Message: fmt.Sprintf("Task found: %s", task), Item: "Taskfile.yml"})
 		} else {
-			results = append(results, ValidationResult{"warning", "No test functions found", "functions"})
+			results = append(results, ValidationResult{Type: "warning", Message: fmt.Sprintf("Task missing: %s", task), Item: "Taskfile.yml"})
 		}
 	}
 
-	if len(functions) > 0 {
-		results = append(results, ValidationResult{"success", fmt.Sprintf("Analyzed %d functions", len(functions)), "functions"})
-	}
-
-	return results
-}
-
-// validatePythonFunctions uses pyfuncs.py to validate Python function patterns
-func validatePythonFunctions(config ProjectConfig, projectDir string) []ValidationResult {
-	var results []ValidationResult
-
-	// Find pyfuncs.py - try multiple locations
-	var pyfuncsPath string
-	locations := []string{
-		filepath.Join(projectDir, "utils", "pyfuncs.py"),
-		filepath.Join(projectDir, "..", "pyfuncs.py"),
-		filepath.Join(projectDir, "..", "..", "utils", "pyfuncs.py"),
-	}
-
-	for _, path := range locations {
-		if fileExists(path) {
-			pyfuncsPath = path
-			break
-		}
-	}
-
-	if pyfuncsPath == "" {
-		results = append(results, ValidationResult{"warning", "pyfuncs.py not found, skipping function validation", "functions"})
-		return results
-	}
-
-	// Run pyfuncs.py
-	cmd := exec.Command("python3", pyfuncsPath, "--dir", projectDir)
-	output, err := cmd.Output()
-	if err != nil {
-		results = append(results, ValidationResult{"error", fmt.Sprintf("Error running pyfuncs: %v", err), "functions"})
-		return results
-	}
-
-	functions := strings.Split(strings.TrimSpace(string(output)), "\n")
-	if len(functions) == 1 && functions[0] == "" {
-		functions = []string{}
-	}
-
-	// Check for main functions if required
-	if config.HasMain {
-		hasMain := false
-		for _, fn := range functions {
-			if strings.Contains(fn, ":f:y:main:") {
-				hasMain = true
-				break
-			}
-		}
-		if hasMain {
-			results = append(results, ValidationResult{"success", "Found main function", "functions"})
-		} else {
-			results = append(results, ValidationResult{"warning", "No main function found", "functions"})
-		}
-	}
-
-	// Check for test functions
-	if config.HasTests {
-		hasTests := false
-		for _, fn := range functions {
-			if strings.Contains(fn, ":f:y:test_") {
-				hasTests = true
-				break
-			}
-		}
-		if hasTests {
-			results = append(results, ValidationResult{"success", "Found test functions", "functions"})
-		} else {
-			results = append(results, ValidationResult{"warning", "No test functions found", "functions"})
-		}
-	}
-
-	if len(functions) > 0 {
-		results = append(results, ValidationResult{"success", fmt.Sprintf("Analyzed %d functions", len(functions)), "functions"})
-	}
-
 	return results
 }
 
@@ -420,22 +223,47 @@ func printResults(results []ValidationResult) {
 			icon = "⚠️"
 		case "success":
 			icon = "✅"
+		case "security":
+			icon = "🔒"
+			if result.Severity == "error" {
+				icon = "🚨"
+			}
 		}
 		fmt.Printf("%s %s\n", icon, result.Message)
 	}
 }
 
-// countErrors counts the number of error results
-func countErrors(results []ValidationResult) int {
+// countFailures counts the structural "error" results plus any "security"
+// findings at or above failOn ("error", "warning", or "none" to disable the
+// security gate entirely).
+func countFailures(results []ValidationResult, failOn string) int {
 	count := 0
 	for _, result := range results {
-		if result.Type == "error" {
+		switch result.Type {
+		case "error":
 			count++
+		case "security":
+			if securityFails(result.Severity, failOn) {
+				count++
+			}
 		}
 	}
 	return count
 }
 
+// securityFails reports whether a finding of the given severity should fail
+// validation under failOn.
+func securityFails(severity, failOn string) bool {
+	switch failOn {
+	case "none":
+		return false
+	case "warning":
+		return severity == "error" || severity == "warning"
+	default: // "error"
+		return severity == "error"
+	}
+}
+
 // Utility functions
 func fileExists(path string) bool {
 	_, err := os.Stat(path)
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// watchPollInterval is how often runWatch re-scans the project tree. The
+// module's go.mod has no fsnotify/inotify dependency, so watch mode polls
+// mtimes on a plain interval instead of receiving filesystem events.
+const watchPollInterval = 500 * time.Millisecond
+
+// runWatch implements `validate watch`: it polls projectDir for changes and
+// re-runs only the validators relevant to what changed.
+func runWatch(args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	projectDir := fs.String("dir", ".", "Project directory to watch")
+	failOn := fs.String("fail-on", "error", "Minimum security finding severity that fails validation (error, warning, none)")
+	fs.Parse(args)
+
+	config, err := autoDetectProject(*projectDir)
+	if err != nil {
+		return fmt.Errorf("detecting project: %w", err)
+	}
+
+	ignore := loadValidatorIgnore(*projectDir)
+
+	state, err := snapshotTree(*projectDir, ignore)
+	if err != nil {
+		return fmt.Errorf("watching %s: %w", *projectDir, err)
+	}
+
+	fmt.Printf("Watching %s for changes (Ctrl+C to stop)...\n", *projectDir)
+
+	for {
+		time.Sleep(watchPollInterval)
+
+		next, err := snapshotTree(*projectDir, ignore)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "watch error: %v\n", err)
+			continue
+		}
+
+		changed := diffTrees(state, next)
+		state = next
+
+		if len(changed) > 0 {
+			revalidate(config, *projectDir, *failOn, changed)
+		}
+	}
+}
+
+// snapshotTree walks projectDir and records the modification time of every
+// non-ignored file, keyed by path relative to projectDir.
+func snapshotTree(projectDir string, ignore []string) (map[string]time.Time, error) {
+	snapshot := make(map[string]time.Time)
+
+	err := filepath.Walk(projectDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, _ := filepath.Rel(projectDir, path)
+
+		if info.IsDir() {
+			if isAlwaysIgnoredDir(info.Name()) {
+				return filepath.SkipDir
+			}
+			if rel != "." && shouldIgnore(rel, ignore) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if shouldIgnore(rel, ignore) {
+			return nil
+		}
+
+		snapshot[rel] = info.ModTime()
+		return nil
+	})
+
+	return snapshot, err
+}
+
+// diffTrees returns the set of paths that were added, removed, or modified
+// between two snapshotTree results.
+func diffTrees(prev, next map[string]time.Time) map[string]bool {
+	changed := make(map[string]bool)
+
+	for path, mtime := range next {
+		if prevMtime, ok := prev[path]; !ok || !prevMtime.Equal(mtime) {
+			changed[path] = true
+		}
+	}
+	for path := range prev {
+		if _, ok := next[path]; !ok {
+			changed[path] = true
+		}
+	}
+
+	return changed
+}
+
+// revalidate re-runs only the validators relevant to the changed paths:
+// a function and security scan for *.go/*.py changes, a Taskfile scan when
+// Taskfile.yml changed, and a structure scan for any other changed path.
+// Snapshots record files only, so a directory shows up as its files changing.
+func revalidate(config ProjectConfig, projectDir, failOn string, changed map[string]bool) {
+	var results []ValidationResult
+	runFunctions, runStructure, runTaskfile := false, false, false
+
+	for path := range changed {
+		switch {
+		case strings.HasSuffix(path, ".go") || strings.HasSuffix(path, ".py"):
+			runFunctions = true
+		case path == "Taskfile.yml":
+			runTaskfile = true
+		default:
+			runStructure = true
+		}
+	}
+
+	if runStructure {
+		results = append(results, validateDirectories(config.Directories, projectDir)...)
+		results = append(results, validateFiles(config.Files, projectDir)...)
+	}
+	if runTaskfile && len(config.BuildTasks) > 0 {
+		results = append(results, validateBuildSystem(config.BuildTasks, projectDir)...)
+	}
+	if runFunctions {
+		results = append(results, validateFunctions(config, projectDir, nil)...)
+		results = append(results, validateSecurity(config, projectDir, failOn, nil)...)
+	}
+
+	fmt.Printf("\n--- %s: %d file(s) changed ---\n", time.Now().Format("15:04:05"), len(changed))
+	printResults(results)
+}
+
+// loadValidatorIgnore reads glob patterns from .validatorignore in
+// projectDir, one per line; blank lines and "#" comments are skipped.
+func loadValidatorIgnore(projectDir string) []string {
+	f, err := os.Open(filepath.Join(projectDir, ".validatorignore"))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var patterns []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+	return patterns
+}
+
+// shouldIgnore reports whether rel (a path relative to the project root)
+// matches any .validatorignore glob or an always-ignored directory.
+func shouldIgnore(rel string, patterns []string) bool {
+	for _, part := range strings.Split(rel, string(filepath.Separator)) {
+		if isAlwaysIgnoredDir(part) {
+			return true
+		}
+	}
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, rel); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, filepath.Base(rel)); ok {
+			return true
+		}
+	}
+	return false
+}